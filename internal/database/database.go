@@ -46,6 +46,11 @@ func Initialize() error {
 		&models.Transaction{},
 		&models.Contract{},
 		&models.BlockProgress{},
+		&models.BlockHash{},
+		&models.BackfillRange{},
+		&models.Event{},
+		&models.ContractCommand{},
+		&models.ReorgEvent{},
 	); err != nil {
 		return fmt.Errorf("failed to migrate database: %w", err)
 	}
@@ -0,0 +1,120 @@
+// Package decoder loads a contract's ABI and decodes its logs into generic
+// models.Event rows, so events beyond the hardcoded ERC-20 Transfer topic
+// (ERC-721 Transfers, ERC-1155 TransferSingle/Batch, Approvals, and custom
+// protocol events) aren't silently dropped by the indexer.
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/user/coin-indexer/internal/models"
+)
+
+// Decoder decodes logs for a single contract using its ABI, restricted to
+// an allowlist of event names.
+type Decoder struct {
+	contractABI abi.ABI
+	events      map[string]abi.Event
+}
+
+// New loads a contract's ABI from abiFile and restricts decoding to
+// eventNames. An empty eventNames decodes every event declared in the ABI.
+func New(abiFile string, eventNames []string) (*Decoder, error) {
+	f, err := os.Open(abiFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ABI file %s: %w", abiFile, err)
+	}
+	defer f.Close()
+
+	contractABI, err := abi.JSON(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI file %s: %w", abiFile, err)
+	}
+
+	events := make(map[string]abi.Event)
+	if len(eventNames) == 0 {
+		for name, event := range contractABI.Events {
+			events[name] = event
+		}
+	} else {
+		for _, name := range eventNames {
+			event, ok := contractABI.Events[name]
+			if !ok {
+				return nil, fmt.Errorf("event %s not found in ABI %s", name, abiFile)
+			}
+			events[name] = event
+		}
+	}
+
+	return &Decoder{contractABI: contractABI, events: events}, nil
+}
+
+// Topics returns the FilterQuery topic filter selecting the decoder's
+// allowlisted events.
+func (d *Decoder) Topics() [][]common.Hash {
+	selectors := make([]common.Hash, 0, len(d.events))
+	for _, event := range d.events {
+		selectors = append(selectors, event.ID)
+	}
+	return [][]common.Hash{selectors}
+}
+
+// eventByTopic returns the ABI event definition matching a log's first
+// topic, or false if it isn't in the decoder's allowlist.
+func (d *Decoder) eventByTopic(topic common.Hash) (abi.Event, bool) {
+	for _, event := range d.events {
+		if event.ID == topic {
+			return event, true
+		}
+	}
+	return abi.Event{}, false
+}
+
+// Decode unpacks a log into a generic models.Event using the matching ABI
+// event definition, combining both indexed (topic) and non-indexed (data)
+// arguments into Args.
+func (d *Decoder) Decode(vLog types.Log) (*models.Event, error) {
+	if len(vLog.Topics) == 0 {
+		return nil, fmt.Errorf("log has no topics")
+	}
+
+	event, ok := d.eventByTopic(vLog.Topics[0])
+	if !ok {
+		return nil, fmt.Errorf("no ABI event matches topic %s", vLog.Topics[0].Hex())
+	}
+
+	args := make(map[string]interface{})
+	if err := d.contractABI.UnpackIntoMap(args, event.Name, vLog.Data); err != nil {
+		return nil, fmt.Errorf("failed to unpack event %s: %w", event.Name, err)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, vLog.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("failed to parse indexed topics for event %s: %w", event.Name, err)
+	}
+
+	encodedArgs, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode args for event %s: %w", event.Name, err)
+	}
+
+	return &models.Event{
+		ContractAddress: vLog.Address.Hex(),
+		EventName:       event.Name,
+		BlockNumber:     vLog.BlockNumber,
+		LogIndex:        uint(vLog.Index),
+		TxHash:          vLog.TxHash.Hex(),
+		Args:            string(encodedArgs),
+	}, nil
+}
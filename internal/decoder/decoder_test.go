@@ -0,0 +1,88 @@
+package decoder
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const transferABI = `[
+	{
+		"anonymous": false,
+		"inputs": [
+			{"indexed": true, "name": "from", "type": "address"},
+			{"indexed": true, "name": "to", "type": "address"},
+			{"indexed": false, "name": "value", "type": "uint256"}
+		],
+		"name": "Transfer",
+		"type": "event"
+	}
+]`
+
+// writeTestABI writes transferABI to a temp file and returns its path.
+func writeTestABI(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "erc20.json")
+	if err := os.WriteFile(path, []byte(transferABI), 0o644); err != nil {
+		t.Fatalf("failed to write ABI fixture: %v", err)
+	}
+	return path
+}
+
+func TestDecodeTransferEvent(t *testing.T) {
+	abiFile := writeTestABI(t)
+
+	d, err := New(abiFile, []string{"Transfer"})
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(transferABI))
+	if err != nil {
+		t.Fatalf("failed to parse fixture ABI: %v", err)
+	}
+	event := parsed.Events["Transfer"]
+
+	from := common.HexToAddress("0x00000000000000000000000000000000000aAa")
+	to := common.HexToAddress("0x00000000000000000000000000000000000bBb")
+	value := big.NewInt(42)
+
+	vLog := types.Log{
+		Address:     common.HexToAddress("0xC00000000000000000000000000000000000Cc"),
+		Topics:      []common.Hash{event.ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:        common.LeftPadBytes(value.Bytes(), 32),
+		BlockNumber: 100,
+		TxHash:      common.HexToHash("0xdead"),
+	}
+
+	got, err := d.Decode(vLog)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if got.EventName != "Transfer" {
+		t.Fatalf("expected event name Transfer, got %s", got.EventName)
+	}
+	if got.BlockNumber != 100 {
+		t.Fatalf("expected block number 100, got %d", got.BlockNumber)
+	}
+}
+
+func TestDecodeRejectsUnknownTopic(t *testing.T) {
+	abiFile := writeTestABI(t)
+
+	d, err := New(abiFile, []string{"Transfer"})
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+
+	vLog := types.Log{Topics: []common.Hash{common.HexToHash("0xdeadbeef")}}
+	if _, err := d.Decode(vLog); err == nil {
+		t.Fatal("expected an error decoding a log whose topic matches no configured event")
+	}
+}
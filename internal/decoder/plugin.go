@@ -0,0 +1,89 @@
+package decoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/user/coin-indexer/internal/database"
+	"github.com/user/coin-indexer/internal/models"
+)
+
+// Plugin post-processes a decoded event to populate a specialized table,
+// alongside the generic models.Event row the indexer always saves for a
+// decoded log.
+type Plugin interface {
+	// Handles reports whether this plugin processes the given event name.
+	Handles(eventName string) bool
+	// Apply persists any specialized side effects for the decoded event.
+	Apply(contractName string, event *models.Event, blockTimestamp time.Time) error
+}
+
+// TransferPlugin keeps the specialized models.Transaction table populated
+// for ERC-20 and ERC-721 Transfer events decoded via the generic Decoder.
+type TransferPlugin struct{}
+
+// Handles reports whether eventName is a Transfer-like event.
+func (TransferPlugin) Handles(eventName string) bool {
+	return eventName == "Transfer"
+}
+
+// Apply decodes from/to/value out of the event's JSON args and writes a
+// models.Transaction row. Numbers are read as json.Number, so a large value
+// keeps every digit instead of being formatted as a float.
+func (TransferPlugin) Apply(contractName string, event *models.Event, blockTimestamp time.Time) error {
+	var args map[string]interface{}
+	dec := json.NewDecoder(strings.NewReader(event.Args))
+	dec.UseNumber()
+	if err := dec.Decode(&args); err != nil {
+		return fmt.Errorf("failed to decode Transfer args: %w", err)
+	}
+
+	from := addressArg(args["from"])
+	to := addressArg(args["to"])
+	amount := fmt.Sprintf("%v", args["value"])
+	if amount == "" || amount == "<nil>" {
+		amount = fmt.Sprintf("%v", args["tokenId"])
+	}
+
+	tx := &models.Transaction{
+		ChainID:         event.ChainID,
+		TxHash:          event.TxHash,
+		BlockNumber:     event.BlockNumber,
+		LogIndex:        event.LogIndex,
+		ContractAddress: event.ContractAddress,
+		TokenName:       contractName,
+		FromAddress:     from,
+		ToAddress:       to,
+		Amount:          amount,
+		BlockTimestamp:  blockTimestamp,
+	}
+
+	db := database.GetDB()
+	if err := db.Create(tx).Error; err != nil {
+		return fmt.Errorf("failed to save transaction: %w", err)
+	}
+
+	return nil
+}
+
+// addressArg normalizes a decoded "address" event argument to a checksummed
+// hex string. go-ethereum's ABI decoder unpacks it as a common.Address, but
+// by the time it reaches here it has round-tripped through Event.Args' JSON
+// encoding and come back as a plain hex string, so both forms are handled -
+// either way the result matches the checksummed format .Hex() produces
+// elsewhere (e.g. processTransferEvent), instead of the lowercase hex JSON
+// round-tripping alone would leave it in.
+func addressArg(v interface{}) string {
+	switch addr := v.(type) {
+	case common.Address:
+		return addr.Hex()
+	case string:
+		return common.HexToAddress(addr).Hex()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,66 @@
+package decoder
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/user/coin-indexer/internal/database"
+	"github.com/user/coin-indexer/internal/models"
+)
+
+func TestAddressArgNormalizesToChecksummedHex(t *testing.T) {
+	// A lowercase hex string is what args["from"]/args["to"] actually hold
+	// by the time TransferPlugin.Apply sees them, once they've round-tripped
+	// through Event.Args' JSON encoding.
+	const lowercase = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	const checksummed = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	if got := addressArg(lowercase); got != checksummed {
+		t.Fatalf("expected checksummed address %q, got %q", checksummed, got)
+	}
+}
+
+func TestAddressArgRejectsUnknownType(t *testing.T) {
+	if got := addressArg(42); got != "" {
+		t.Fatalf("expected an empty string for a non-address arg, got %q", got)
+	}
+}
+
+func TestTransferPluginApplyPopulatesTransaction(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open in-memory sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&models.Transaction{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	database.DB = db
+
+	event := &models.Event{
+		ChainID:         1,
+		ContractAddress: "0xC00000000000000000000000000000000000Cc",
+		EventName:       "Transfer",
+		BlockNumber:     100,
+		LogIndex:        0,
+		TxHash:          "0xdead",
+		Args:            `{"from":"0x00000000000000000000000000000000000aaa","to":"0x00000000000000000000000000000000000bbb","value":42}`,
+	}
+
+	if err := (TransferPlugin{}).Apply("TestToken", event, time.Unix(0, 0)); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+
+	var tx models.Transaction
+	if err := db.First(&tx).Error; err != nil {
+		t.Fatalf("failed to load saved transaction: %v", err)
+	}
+	if tx.FromAddress == "" || tx.ToAddress == "" {
+		t.Fatalf("expected non-empty from/to addresses, got %+v", tx)
+	}
+	if tx.Amount != "42" {
+		t.Fatalf("expected amount 42, got %q", tx.Amount)
+	}
+}
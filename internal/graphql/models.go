@@ -0,0 +1,20 @@
+package graphql
+
+// IndexerStatus reports backfill/tailing progress for a single contract.
+// BlocksPerSec and ETASeconds are approximated from recently completed
+// BackfillRange checkpoints, since this process has no access to the
+// indexer's live Backfiller.
+type IndexerStatus struct {
+	ChainID         int     `json:"chainId"`
+	Contract        string  `json:"contract"`
+	LastBlock       int     `json:"lastBlock"`
+	RangesCompleted int     `json:"rangesCompleted"`
+	BlocksPerSec    float64 `json:"blocksPerSec"`
+	ETASeconds      int     `json:"etaSeconds"`
+}
+
+// ReorgEvent reports a chain reorg rollback pushed to "reorg" subscribers.
+type ReorgEvent struct {
+	ForkBlock       int      `json:"forkBlock"`
+	DroppedTxHashes []string `json:"droppedTxHashes"`
+}
@@ -0,0 +1,338 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/user/coin-indexer/internal/database"
+	"github.com/user/coin-indexer/internal/models"
+)
+
+// ID is the resolver for the id field.
+func (r *contractResolver) ID(ctx context.Context, obj *models.Contract) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+// ChainID is the resolver for the chainId field.
+func (r *contractResolver) ChainID(ctx context.Context, obj *models.Contract) (int, error) {
+	return int(obj.ChainID), nil
+}
+
+// StartBlock is the resolver for the startBlock field.
+func (r *contractResolver) StartBlock(ctx context.Context, obj *models.Contract) (int, error) {
+	return int(obj.StartBlock), nil
+}
+
+// LastBlock is the resolver for the lastBlock field, read from BlockProgress
+// rather than the Contract row, which is never updated after discovery -
+// the same source indexerStatus uses.
+func (r *contractResolver) LastBlock(ctx context.Context, obj *models.Contract) (int, error) {
+	var progress models.BlockProgress
+	database.GetDB().Where("chain_id = ? AND contract = ?", obj.ChainID, obj.Address).First(&progress)
+	return int(progress.LastBlock), nil
+}
+
+// ID is the resolver for the id field.
+func (r *eventResolver) ID(ctx context.Context, obj *models.Event) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+// ChainID is the resolver for the chainId field.
+func (r *eventResolver) ChainID(ctx context.Context, obj *models.Event) (int, error) {
+	return int(obj.ChainID), nil
+}
+
+// BlockNumber is the resolver for the blockNumber field.
+func (r *eventResolver) BlockNumber(ctx context.Context, obj *models.Event) (int, error) {
+	return int(obj.BlockNumber), nil
+}
+
+// LogIndex is the resolver for the logIndex field.
+func (r *eventResolver) LogIndex(ctx context.Context, obj *models.Event) (int, error) {
+	return int(obj.LogIndex), nil
+}
+
+// Transactions is the resolver for the transactions field.
+func (r *queryResolver) Transactions(ctx context.Context, limit *int, chainID *int, contract *string, fromAddress *string, toAddress *string) ([]*models.Transaction, error) {
+	db := database.GetDB()
+	query := db.Model(&models.Transaction{}).Order("block_number desc")
+
+	if chainID != nil {
+		query = query.Where("chain_id = ?", *chainID)
+	}
+	if contract != nil {
+		query = query.Where("contract_address = ?", *contract)
+	}
+	if fromAddress != nil {
+		query = query.Where("from_address = ?", *fromAddress)
+	}
+	if toAddress != nil {
+		query = query.Where("to_address = ?", *toAddress)
+	}
+	if limit != nil {
+		query = query.Limit(*limit)
+	}
+
+	var transactions []*models.Transaction
+	if err := query.Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// Contracts is the resolver for the contracts field.
+func (r *queryResolver) Contracts(ctx context.Context, chainID *int) ([]*models.Contract, error) {
+	db := database.GetDB()
+	query := db.Model(&models.Contract{})
+
+	if chainID != nil {
+		query = query.Where("chain_id = ?", *chainID)
+	}
+
+	var contracts []*models.Contract
+	if err := query.Find(&contracts).Error; err != nil {
+		return nil, err
+	}
+	return contracts, nil
+}
+
+// IndexerStatus is the resolver for the indexerStatus field.
+func (r *queryResolver) IndexerStatus(ctx context.Context, chainID *int) ([]*IndexerStatus, error) {
+	db := database.GetDB()
+
+	query := db.Model(&models.Contract{})
+	if chainID != nil {
+		query = query.Where("chain_id = ?", *chainID)
+	}
+
+	var contracts []models.Contract
+	if err := query.Find(&contracts).Error; err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*IndexerStatus, 0, len(contracts))
+	for _, c := range contracts {
+		var progress models.BlockProgress
+		db.Where("chain_id = ? AND contract = ?", c.ChainID, c.Address).First(&progress)
+
+		var ranges int64
+		db.Model(&models.BackfillRange{}).
+			Where("chain_id = ? AND contract_address = ? AND completed = ?", c.ChainID, c.Address, true).
+			Count(&ranges)
+
+		var recentlyCompleted []models.BackfillRange
+		db.Where("chain_id = ? AND contract_address = ? AND completed = ? AND updated_at >= ?",
+			c.ChainID, c.Address, true, time.Now().Add(-backfillRateWindow)).
+			Find(&recentlyCompleted)
+
+		var recentBlocks uint64
+		for _, r := range recentlyCompleted {
+			recentBlocks += r.ToBlock - r.FromBlock + 1
+		}
+		blocksPerSec := float64(recentBlocks) / backfillRateWindow.Seconds()
+
+		var pending []models.BackfillRange
+		db.Where("chain_id = ? AND contract_address = ? AND completed = ?", c.ChainID, c.Address, false).
+			Find(&pending)
+
+		var pendingBlocks uint64
+		for _, r := range pending {
+			pendingBlocks += r.ToBlock - r.FromBlock + 1
+		}
+
+		var etaSeconds int
+		if blocksPerSec > 0 {
+			etaSeconds = int(float64(pendingBlocks) / blocksPerSec)
+		}
+
+		statuses = append(statuses, &IndexerStatus{
+			ChainID:         int(c.ChainID),
+			Contract:        c.Name,
+			LastBlock:       int(progress.LastBlock),
+			RangesCompleted: int(ranges),
+			BlocksPerSec:    blocksPerSec,
+			ETASeconds:      etaSeconds,
+		})
+	}
+
+	return statuses, nil
+}
+
+// Events is the resolver for the events field.
+func (r *queryResolver) Events(ctx context.Context, contract string, chainID *int, name *string, fromBlock *int, toBlock *int) ([]*models.Event, error) {
+	db := database.GetDB()
+	query := db.Model(&models.Event{}).Where("contract_address = ?", contract).Order("block_number desc")
+
+	if chainID != nil {
+		query = query.Where("chain_id = ?", *chainID)
+	}
+	if name != nil {
+		query = query.Where("event_name = ?", *name)
+	}
+	if fromBlock != nil {
+		query = query.Where("block_number >= ?", *fromBlock)
+	}
+	if toBlock != nil {
+		query = query.Where("block_number <= ?", *toBlock)
+	}
+
+	var events []*models.Event
+	if err := query.Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// NewTransactions is the resolver for the newTransactions field. It polls
+// the Transaction table rather than subscribing to an in-process bus, since
+// the indexer that creates these rows runs as a separate process.
+func (r *subscriptionResolver) NewTransactions(ctx context.Context, contract *string, fromAddress *string, toAddress *string) (<-chan *models.Transaction, error) {
+	out := make(chan *models.Transaction, 1)
+
+	go func() {
+		defer close(out)
+
+		db := database.GetDB()
+		var lastID uint
+		if err := db.Model(&models.Transaction{}).Select("COALESCE(MAX(id), 0)").Scan(&lastID).Error; err != nil {
+			log.Printf("newTransactions subscription: failed to seed last id: %v", err)
+		}
+
+		ticker := time.NewTicker(subscriptionPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				query := db.Where("id > ?", lastID).Order("id asc")
+				if contract != nil {
+					query = query.Where("contract_address = ?", *contract)
+				}
+				if fromAddress != nil {
+					query = query.Where("from_address = ?", *fromAddress)
+				}
+				if toAddress != nil {
+					query = query.Where("to_address = ?", *toAddress)
+				}
+
+				var txs []*models.Transaction
+				if err := query.Find(&txs).Error; err != nil {
+					log.Printf("newTransactions subscription: poll failed: %v", err)
+					continue
+				}
+
+				for _, tx := range txs {
+					lastID = tx.ID
+					select {
+					case out <- tx:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Reorg is the resolver for the reorg field. It polls the ReorgEvent table
+// rather than subscribing to an in-process bus, since the indexer that
+// records reorgs runs as a separate process.
+func (r *subscriptionResolver) Reorg(ctx context.Context, contract *string) (<-chan *ReorgEvent, error) {
+	out := make(chan *ReorgEvent, 1)
+
+	go func() {
+		defer close(out)
+
+		db := database.GetDB()
+		var lastID uint
+		if err := db.Model(&models.ReorgEvent{}).Select("COALESCE(MAX(id), 0)").Scan(&lastID).Error; err != nil {
+			log.Printf("reorg subscription: failed to seed last id: %v", err)
+		}
+
+		ticker := time.NewTicker(subscriptionPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				query := db.Where("id > ?", lastID).Order("id asc")
+				if contract != nil {
+					query = query.Where("contract_address = ?", *contract)
+				}
+
+				var rows []models.ReorgEvent
+				if err := query.Find(&rows).Error; err != nil {
+					log.Printf("reorg subscription: poll failed: %v", err)
+					continue
+				}
+
+				for _, row := range rows {
+					lastID = row.ID
+					select {
+					case out <- &ReorgEvent{ForkBlock: int(row.ForkBlock), DroppedTxHashes: []string(row.DroppedTxHashes)}:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// ID is the resolver for the id field.
+func (r *transactionResolver) ID(ctx context.Context, obj *models.Transaction) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+// ChainID is the resolver for the chainId field.
+func (r *transactionResolver) ChainID(ctx context.Context, obj *models.Transaction) (int, error) {
+	return int(obj.ChainID), nil
+}
+
+// BlockNumber is the resolver for the blockNumber field.
+func (r *transactionResolver) BlockNumber(ctx context.Context, obj *models.Transaction) (int, error) {
+	return int(obj.BlockNumber), nil
+}
+
+// LogIndex is the resolver for the logIndex field.
+func (r *transactionResolver) LogIndex(ctx context.Context, obj *models.Transaction) (int, error) {
+	return int(obj.LogIndex), nil
+}
+
+// Contract returns ContractResolver implementation.
+func (r *Resolver) Contract() ContractResolver { return &contractResolver{r} }
+
+// Event returns EventResolver implementation.
+func (r *Resolver) Event() EventResolver { return &eventResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+// Transaction returns TransactionResolver implementation.
+func (r *Resolver) Transaction() TransactionResolver { return &transactionResolver{r} }
+
+type (
+	contractResolver     struct{ *Resolver }
+	eventResolver        struct{ *Resolver }
+	queryResolver        struct{ *Resolver }
+	subscriptionResolver struct{ *Resolver }
+	transactionResolver  struct{ *Resolver }
+)
@@ -0,0 +1,188 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/spf13/viper"
+
+	"github.com/user/coin-indexer/internal/database"
+	"github.com/user/coin-indexer/internal/models"
+)
+
+// Backfiller catches a contract up from its current progress to a target
+// block by splitting the range into batches and processing them across a
+// pool of worker goroutines. Each batch is checkpointed into BackfillRange
+// so a restarted backfill resumes instead of re-scanning completed ranges.
+type Backfiller struct {
+	indexer *Indexer
+	client  *ethclient.Client
+
+	mu        sync.Mutex
+	startedAt time.Time
+	processed uint64
+}
+
+// NewBackfiller creates a Backfiller bound to the given indexer and client.
+func NewBackfiller(i *Indexer, client *ethclient.Client) *Backfiller {
+	return &Backfiller{indexer: i, client: client}
+}
+
+// Run backfills config from fromBlock to targetBlock (inclusive) using a
+// pool of worker goroutines, and advances BlockProgress once the full range
+// has been indexed.
+func (b *Backfiller) Run(ctx context.Context, config ContractConfig, fromBlock, targetBlock uint64) error {
+	if fromBlock > targetBlock {
+		return nil
+	}
+
+	workers := viper.GetInt("indexing.backfill_workers")
+	if workers < 1 {
+		workers = 1
+	}
+	batchSize := uint64(viper.GetInt("indexing.backfill_batch_size"))
+	if batchSize == 0 {
+		batchSize = uint64(viper.GetInt("indexing.batch_size"))
+	}
+
+	ranges := b.pendingRanges(config, fromBlock, targetBlock, batchSize)
+
+	// Record every range still to do as a not-yet-completed BackfillRange, so
+	// indexerStatus can estimate the remaining work from those rows.
+	db := database.GetDB()
+	for _, r := range ranges {
+		row := r
+		db.Where("chain_id = ? AND contract_address = ? AND from_block = ?", r.ChainID, r.ContractAddress, r.FromBlock).
+			Assign(models.BackfillRange{ToBlock: r.ToBlock}).
+			FirstOrCreate(&row)
+	}
+
+	b.startedAt = time.Now()
+
+	jobs := make(chan models.BackfillRange)
+	var wg sync.WaitGroup
+	var errMu sync.Mutex
+	var firstErr error
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range jobs {
+				select {
+				case <-ctx.Done():
+					continue
+				default:
+				}
+
+				if err := b.processRange(config, r); err != nil {
+					errMu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errMu.Unlock()
+					continue
+				}
+
+				b.mu.Lock()
+				b.processed += r.ToBlock - r.FromBlock + 1
+				b.mu.Unlock()
+			}
+		}()
+	}
+
+	for _, r := range ranges {
+		select {
+		case jobs <- r:
+		case <-ctx.Done():
+			close(jobs)
+			wg.Wait()
+			return ctx.Err()
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	b.indexer.updateLastProcessedBlock(config.ChainID, config.Address, targetBlock)
+
+	log.Printf("Backfill complete for %s: %d blocks in %s", config.Name, b.processed, time.Since(b.startedAt))
+	return nil
+}
+
+// pendingRanges splits [fromBlock, targetBlock] into batchSize-sized
+// batches, skipping any already marked completed in BackfillRange.
+func (b *Backfiller) pendingRanges(config ContractConfig, fromBlock, targetBlock, batchSize uint64) []models.BackfillRange {
+	db := database.GetDB()
+
+	var completed []models.BackfillRange
+	db.Where("chain_id = ? AND contract_address = ? AND completed = ?", config.ChainID, config.Address.Hex(), true).Find(&completed)
+
+	done := make(map[uint64]bool, len(completed))
+	for _, r := range completed {
+		done[r.FromBlock] = true
+	}
+
+	var pending []models.BackfillRange
+	for start := fromBlock; start <= targetBlock; start += batchSize {
+		end := start + batchSize - 1
+		if end > targetBlock {
+			end = targetBlock
+		}
+		if done[start] {
+			continue
+		}
+		pending = append(pending, models.BackfillRange{
+			ChainID:         config.ChainID,
+			ContractAddress: config.Address.Hex(),
+			FromBlock:       start,
+			ToBlock:         end,
+		})
+	}
+
+	return pending
+}
+
+// processRange indexes a single batch and checkpoints it as completed.
+func (b *Backfiller) processRange(config ContractConfig, r models.BackfillRange) error {
+	if err := b.indexer.processBlockRange(config, r.FromBlock, r.ToBlock); err != nil {
+		return fmt.Errorf("backfill worker failed on blocks %d-%d: %w", r.FromBlock, r.ToBlock, err)
+	}
+
+	r.Completed = true
+	db := database.GetDB()
+	if err := db.Where("chain_id = ? AND contract_address = ? AND from_block = ?", r.ChainID, r.ContractAddress, r.FromBlock).
+		Assign(r).
+		FirstOrCreate(&r).Error; err != nil {
+		return fmt.Errorf("failed to checkpoint backfill range %d-%d: %w", r.FromBlock, r.ToBlock, err)
+	}
+
+	return nil
+}
+
+// Progress reports blocks/sec and an ETA for the in-flight backfill, given
+// the current target and already-processed tip.
+func (b *Backfiller) Progress(target, current uint64) (blocksPerSec float64, eta time.Duration) {
+	b.mu.Lock()
+	processed := b.processed
+	started := b.startedAt
+	b.mu.Unlock()
+
+	elapsed := time.Since(started).Seconds()
+	if elapsed <= 0 || processed == 0 || target <= current {
+		return 0, 0
+	}
+
+	blocksPerSec = float64(processed) / elapsed
+	if blocksPerSec > 0 {
+		eta = time.Duration(float64(target-current)/blocksPerSec) * time.Second
+	}
+	return blocksPerSec, eta
+}
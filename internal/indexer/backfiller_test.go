@@ -0,0 +1,41 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/user/coin-indexer/internal/models"
+)
+
+func TestPendingRangesSkipsCompletedBatches(t *testing.T) {
+	db := openTestDB(t)
+	if err := db.AutoMigrate(&models.BackfillRange{}); err != nil {
+		t.Fatalf("failed to migrate BackfillRange: %v", err)
+	}
+
+	config := ContractConfig{ChainID: 1, Address: common.HexToAddress("0x1")}
+	db.Create(&models.BackfillRange{ChainID: config.ChainID, ContractAddress: config.Address.Hex(), FromBlock: 1, ToBlock: 10, Completed: true})
+	db.Create(&models.BackfillRange{ChainID: config.ChainID, ContractAddress: config.Address.Hex(), FromBlock: 11, ToBlock: 20, Completed: false})
+
+	b := &Backfiller{}
+	ranges := b.pendingRanges(config, 1, 30, 10)
+
+	got := make(map[uint64]uint64, len(ranges))
+	for _, r := range ranges {
+		got[r.FromBlock] = r.ToBlock
+	}
+
+	if _, skipped := got[1]; skipped {
+		t.Fatalf("expected the completed 1-10 range to be skipped, got ranges %+v", ranges)
+	}
+	if toBlock, ok := got[11]; !ok || toBlock != 20 {
+		t.Fatalf("expected a pending 11-20 range, got ranges %+v", ranges)
+	}
+	if toBlock, ok := got[21]; !ok || toBlock != 30 {
+		t.Fatalf("expected a pending 21-30 range, got ranges %+v", ranges)
+	}
+	if len(ranges) != 2 {
+		t.Fatalf("expected exactly 2 pending ranges, got %d: %+v", len(ranges), ranges)
+	}
+}
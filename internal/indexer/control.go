@@ -0,0 +1,171 @@
+package indexer
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/user/coin-indexer/internal/database"
+	"github.com/user/coin-indexer/internal/models"
+)
+
+// commandPollInterval is how often the indexer polls the database for
+// queued ContractCommand rows, since the admin API and the indexer run as
+// separate processes with no shared memory.
+const commandPollInterval = 5 * time.Second
+
+// contractControl holds the per-contract runtime state and control channel
+// used by the admin API to pause, resume, reindex or stop a single
+// contract's monitor goroutine without restarting the process.
+type contractControl struct {
+	stopChan chan struct{}
+	commands chan models.ContractCommand
+	stopOnce sync.Once
+
+	mu         sync.Mutex
+	state      string
+	processed  uint64
+	startedAt  time.Time
+	backfiller *Backfiller
+}
+
+func newContractControl() *contractControl {
+	return &contractControl{
+		stopChan:  make(chan struct{}),
+		commands:  make(chan models.ContractCommand, 4),
+		state:     "starting",
+		startedAt: time.Now(),
+	}
+}
+
+// setState records the control's current lifecycle state for Status().
+func (c *contractControl) setState(state string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.state = state
+}
+
+// getState returns the control's current lifecycle state.
+func (c *contractControl) getState() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.state
+}
+
+// recordProcessed adds n newly processed events to the running total behind
+// eventsPerSec.
+func (c *contractControl) recordProcessed(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.processed += uint64(n)
+}
+
+// eventsPerSec reports the average event processing rate since the control
+// was created.
+func (c *contractControl) eventsPerSec() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elapsed := time.Since(c.startedAt).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(c.processed) / elapsed
+}
+
+// setBackfiller records the Backfiller currently running for this contract,
+// so Status() can report its progress. Pass nil once the backfill finishes.
+func (c *contractControl) setBackfiller(b *Backfiller) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.backfiller = b
+}
+
+// getBackfiller returns the Backfiller currently running for this contract,
+// or nil if it isn't backfilling.
+func (c *contractControl) getBackfiller() *Backfiller {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.backfiller
+}
+
+// stop closes the control's stop channel exactly once, signaling its monitor
+// goroutine to exit.
+func (c *contractControl) stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopChan)
+	})
+}
+
+// controlFor returns the existing control for config, creating one if this
+// is the first time it's been monitored.
+func (i *Indexer) controlFor(config ContractConfig) *contractControl {
+	key := chainAddress{config.ChainID, config.Address}
+
+	i.controlMu.Lock()
+	defer i.controlMu.Unlock()
+
+	ctrl, ok := i.controls[key]
+	if !ok {
+		ctrl = newContractControl()
+		i.controls[key] = ctrl
+	}
+	return ctrl
+}
+
+// pollCommands periodically polls the ContractCommand table for unprocessed
+// rows and routes each to the control of the contract it targets, so the
+// admin API (a separate OS process) can control a live-running indexer.
+func (i *Indexer) pollCommands() {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(commandPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-i.stopChan:
+			return
+		case <-ticker.C:
+			i.dispatchPendingCommands()
+		}
+	}
+}
+
+// dispatchPendingCommands loads every unprocessed ContractCommand, routes it
+// to its contract's control if one is currently monitored, and marks it
+// processed regardless of whether a matching control was found.
+func (i *Indexer) dispatchPendingCommands() {
+	db := database.GetDB()
+
+	var pending []models.ContractCommand
+	if err := db.Where("processed = ?", false).Find(&pending).Error; err != nil {
+		log.Printf("Failed to poll contract commands: %v", err)
+		return
+	}
+
+	for _, cmd := range pending {
+		key := chainAddress{cmd.ChainID, common.HexToAddress(cmd.Address)}
+		i.controlMu.Lock()
+		ctrl, ok := i.controls[key]
+		i.controlMu.Unlock()
+
+		if !ok {
+			log.Printf("Command %s for unmonitored contract %s on chain %d ignored", cmd.Action, cmd.Address, cmd.ChainID)
+		} else if models.ContractAction(cmd.Action) == models.ContractActionStop {
+			ctrl.stop()
+		} else {
+			select {
+			case ctrl.commands <- cmd:
+			default:
+				log.Printf("Command channel full for %s, dropping %s", cmd.Address, cmd.Action)
+			}
+		}
+
+		now := time.Now()
+		db.Model(&models.ContractCommand{}).Where("id = ?", cmd.ID).
+			Updates(map[string]interface{}{"processed": true, "processed_at": now})
+	}
+}
@@ -13,45 +13,114 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/spf13/viper"
-	
+	"gorm.io/gorm"
+
 	"github.com/user/coin-indexer/internal/database"
+	"github.com/user/coin-indexer/internal/decoder"
 	"github.com/user/coin-indexer/internal/models"
 )
 
+// blockHashWindow is the number of recent canonical block hashes kept per
+// contract for reorg detection.
+const blockHashWindow = 128
+
+// transferEventSignature is the keccak256 topic hash of the ERC-20
+// Transfer(address,address,uint256) event.
+const transferEventSignature = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
 type Indexer struct {
-	client     *ethclient.Client
-	contracts  []ContractConfig
-	stopChan   chan struct{}
-	wg         sync.WaitGroup
+	clients   map[uint64]*ethclient.Client
+	chains    map[uint64]chainMeta
+	plugins   []decoder.Plugin
+	stopChan  chan struct{}
+	wg        sync.WaitGroup
+
+	// contractsMu guards contracts and decoders, which the discovery poller
+	// can grow at runtime as new Contract rows appear in the database.
+	contractsMu sync.RWMutex
+	contracts   []ContractConfig
+	decoders    map[chainAddress]*decoder.Decoder
+
+	controlMu sync.Mutex
+	controls  map[chainAddress]*contractControl
 }
 
+// chainMeta holds a configured chain's dial and sync settings, kept on the
+// Indexer so a contract discovered later from the database can be stamped
+// with the same settings its config-file siblings already have.
+type chainMeta struct {
+	ProviderURL   string
+	PollInterval  int
+	Confirmations uint64
+}
+
+// ContractConfig describes a single monitored contract on a single chain.
+// PollInterval and Confirmations are copied down from the contract's chain
+// entry so every function that already threads ContractConfig through
+// doesn't also need a chain lookup.
 type ContractConfig struct {
-	Name       string
-	Address    common.Address
-	StartBlock uint64
+	Name          string
+	Address       common.Address
+	StartBlock    uint64
+	ABIFile       string
+	Events        []string
+	ChainID       uint64
+	ProviderURL   string
+	PollInterval  int
+	Confirmations uint64
 }
 
-// NewIndexer creates a new blockchain indexer
+// chainAddress identifies a contract uniquely across chains, since the same
+// address can be monitored on more than one chain at once.
+type chainAddress struct {
+	ChainID uint64
+	Address common.Address
+}
+
+// NewIndexer creates a new blockchain indexer, dialing one client per
+// configured chain.
 func NewIndexer() (*Indexer, error) {
-	providerURL := viper.GetString("blockchain.provider_url")
-	if providerURL == "" {
-		return nil, fmt.Errorf("blockchain provider URL not configured")
+	clients, chains, contracts, err := loadChainsFromConfig()
+	if err != nil {
+		return nil, err
 	}
-	
-	client, err := ethclient.Dial(providerURL)
+
+	decoders, err := loadDecoders(contracts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to blockchain: %w", err)
+		return nil, fmt.Errorf("failed to load contract ABIs: %w", err)
 	}
-	
-	contracts := loadContractsFromConfig()
-	
+
 	return &Indexer{
-		client:    client,
+		clients:   clients,
+		chains:    chains,
 		contracts: contracts,
+		decoders:  decoders,
+		plugins:   []decoder.Plugin{decoder.TransferPlugin{}},
 		stopChan:  make(chan struct{}),
+		controls:  make(map[chainAddress]*contractControl),
 	}, nil
 }
 
+// loadDecoders builds an ABI decoder for every contract that declares an
+// ABIFile, keyed by chain and contract address.
+func loadDecoders(contracts []ContractConfig) (map[chainAddress]*decoder.Decoder, error) {
+	decoders := make(map[chainAddress]*decoder.Decoder)
+
+	for _, c := range contracts {
+		if c.ABIFile == "" {
+			continue
+		}
+
+		d, err := decoder.New(c.ABIFile, c.Events)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load ABI for %s: %w", c.Name, err)
+		}
+		decoders[chainAddress{c.ChainID, c.Address}] = d
+	}
+
+	return decoders, nil
+}
+
 // Start begins indexing for all configured contracts
 func (i *Indexer) Start() error {
 	log.Println("Starting blockchain indexer...")
@@ -62,11 +131,25 @@ func (i *Indexer) Start() error {
 	}
 	
 	// Start monitoring each contract in its own goroutine
-	for _, contract := range i.contracts {
+	i.contractsMu.RLock()
+	contracts := make([]ContractConfig, len(i.contracts))
+	copy(contracts, i.contracts)
+	i.contractsMu.RUnlock()
+
+	for _, contract := range contracts {
 		i.wg.Add(1)
 		go i.monitorContract(contract)
 	}
-	
+
+	i.wg.Add(1)
+	go i.logStatusPeriodically()
+
+	i.wg.Add(1)
+	go i.pollCommands()
+
+	i.wg.Add(1)
+	go i.discoverContracts()
+
 	// Wait for stop signal
 	<-i.stopChan
 	
@@ -83,42 +166,281 @@ func (i *Indexer) Stop() {
 	close(i.stopChan)
 }
 
-// monitorContract monitors events for a specific contract
+// monitorContract orchestrates a contract's lifecycle: it backfills history
+// up to head - confirmations, then hands off to a LiveTailer for the
+// confirmed tip of the chain.
 func (i *Indexer) monitorContract(config ContractConfig) {
 	defer i.wg.Done()
-	
+
+	ctrl := i.controlFor(config)
+
 	log.Printf("Starting to monitor contract %s at %s", config.Name, config.Address.Hex())
-	
-	ticker := time.NewTicker(time.Duration(viper.GetInt("blockchain.poll_interval")) * time.Second)
-	defer ticker.Stop()
-	
+
 	for {
-		select {
-		case <-i.stopChan:
+		ctrl.setState("backfilling")
+
+		lastBlock := i.getLastProcessedBlock(config.ChainID, config.Address)
+		if lastBlock < config.StartBlock {
+			lastBlock = config.StartBlock
+		}
+
+		target, err := i.confirmedHead(config)
+		if err != nil {
+			log.Printf("Error fetching current block for %s: %v", config.Name, err)
+			return
+		}
+
+		if lastBlock < target {
+			log.Printf("Backfilling %s from block %d to %d", config.Name, lastBlock+1, target)
+
+			backfiller := NewBackfiller(i, i.clients[config.ChainID])
+			ctrl.setBackfiller(backfiller)
+			stopped, cmd, hasCmd, err := i.runBackfill(ctrl, backfiller, config, lastBlock+1, target)
+			ctrl.setBackfiller(nil)
+			if stopped {
+				ctrl.setState("stopped")
+				log.Printf("Stopping monitor for contract %s", config.Name)
+				return
+			}
+			if hasCmd {
+				if i.handleInterruptCommand(ctrl, config, cmd) {
+					return
+				}
+				continue
+			}
+			if err != nil {
+				log.Printf("Backfill failed for %s: %v", config.Name, err)
+				return
+			}
+		}
+
+		log.Printf("%s caught up to confirmed head, handing off to live tailer", config.Name)
+
+		ctrl.setState("tailing")
+		stopped, cmd, hasCmd := i.tailUntilInterrupted(ctrl, config)
+		if stopped {
+			ctrl.setState("stopped")
 			log.Printf("Stopping monitor for contract %s", config.Name)
 			return
-		case <-ticker.C:
-			if err := i.processContractEvents(config); err != nil {
-				log.Printf("Error processing events for %s: %v", config.Name, err)
+		}
+		if !hasCmd {
+			continue
+		}
+		if i.handleInterruptCommand(ctrl, config, cmd) {
+			return
+		}
+	}
+}
+
+// runBackfill runs backfiller over [fromBlock, target], canceling it and
+// returning early if the contract is stopped or a pause/reindex command
+// arrives - mirroring tailUntilInterrupted. Without this, a contract with a
+// very long backfill ahead of it (the exact scenario the backfiller exists
+// for) can't be stopped or paused until that backfill finishes on its own.
+func (i *Indexer) runBackfill(ctrl *contractControl, backfiller *Backfiller, config ContractConfig, fromBlock, target uint64) (stopped bool, cmd models.ContractCommand, hasCmd bool, err error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- backfiller.Run(ctx, config, fromBlock, target)
+	}()
+
+	select {
+	case <-ctrl.stopChan:
+		cancel()
+		<-done
+		return true, models.ContractCommand{}, false, nil
+	case c := <-ctrl.commands:
+		cancel()
+		<-done
+		return false, c, true, nil
+	case e := <-done:
+		return false, models.ContractCommand{}, false, e
+	}
+}
+
+// handleInterruptCommand processes a pause or reindex command that
+// interrupted a backfill or the live tailer, looping on waitForResume for
+// pause until a resume or stop arrives. It returns true if monitorContract
+// should return rather than continue its loop.
+func (i *Indexer) handleInterruptCommand(ctrl *contractControl, config ContractConfig, cmd models.ContractCommand) bool {
+	switch models.ContractAction(cmd.Action) {
+	case models.ContractActionPause:
+		log.Printf("Pausing monitor for contract %s", config.Name)
+		ctrl.setState("paused")
+		if stop := i.waitForResume(ctrl, config); stop {
+			ctrl.setState("stopped")
+			log.Printf("Stopping monitor for contract %s", config.Name)
+			return true
+		}
+	case models.ContractActionReindex:
+		forkPoint, ok := i.reindexForkPoint(config, cmd)
+		if !ok {
+			log.Printf("Reindex rejected for %s: fromBlock %d is ahead of its last processed block", config.Name, cmd.FromBlock)
+			return false
+		}
+		log.Printf("Reindexing %s: rolling back to block %d before re-backfilling to %d", config.Name, forkPoint, cmd.ToBlock)
+		if err := i.rollbackToBlock(config.ChainID, config.Address, forkPoint); err != nil {
+			log.Printf("Reindex failed for %s: %v", config.Name, err)
+			return true
+		}
+		if cmd.ToBlock > 0 {
+			if err := i.reindexTo(ctrl, config, forkPoint, cmd.ToBlock); err != nil {
+				log.Printf("Reindex failed for %s: %v", config.Name, err)
+				return true
 			}
 		}
 	}
+	return false
+}
+
+// reindexForkPoint validates cmd.FromBlock against config's last processed
+// block and translates it into the block to roll back to. A fromBlock ahead
+// of what's actually been indexed would still force BlockProgress up to it
+// via rollbackToBlock, leaving nothing for confirmedHead to consider
+// un-caught-up - permanently stalling the contract with no error surfaced
+// anywhere - so reject it instead.
+func (i *Indexer) reindexForkPoint(config ContractConfig, cmd models.ContractCommand) (forkPoint uint64, ok bool) {
+	if cmd.FromBlock == 0 {
+		return config.StartBlock, true
+	}
+	lastProcessed := i.getLastProcessedBlock(config.ChainID, config.Address)
+	if cmd.FromBlock > lastProcessed+1 {
+		return 0, false
+	}
+	return cmd.FromBlock - 1, true
+}
+
+// tailUntilInterrupted runs the live tailer until the control's stop
+// channel closes or a pause/reindex command arrives, whichever comes first.
+func (i *Indexer) tailUntilInterrupted(ctrl *contractControl, config ContractConfig) (stopped bool, cmd models.ContractCommand, hasCmd bool) {
+	interrupt := make(chan struct{})
+	done := make(chan struct{})
+
+	tailer := NewLiveTailer(i, config)
+	go func() {
+		tailer.Run(config, interrupt)
+		close(done)
+	}()
+
+	select {
+	case <-ctrl.stopChan:
+		close(interrupt)
+		<-done
+		return true, models.ContractCommand{}, false
+	case c := <-ctrl.commands:
+		close(interrupt)
+		<-done
+		return false, c, true
+	}
+}
+
+// waitForResume blocks while a contract is paused until a resume command (or
+// stop) arrives, returning true if the monitor should stop entirely.
+func (i *Indexer) waitForResume(ctrl *contractControl, config ContractConfig) bool {
+	select {
+	case <-ctrl.stopChan:
+		return true
+	case cmd := <-ctrl.commands:
+		if models.ContractAction(cmd.Action) == models.ContractActionReindex {
+			forkPoint, ok := i.reindexForkPoint(config, cmd)
+			if !ok {
+				log.Printf("Reindex while paused rejected for %s: fromBlock %d is ahead of its last processed block", config.Name, cmd.FromBlock)
+			} else if err := i.rollbackToBlock(config.ChainID, config.Address, forkPoint); err != nil {
+				log.Printf("Reindex while paused failed for %s: %v", config.Name, err)
+			} else if cmd.ToBlock > 0 {
+				if err := i.reindexTo(ctrl, config, forkPoint, cmd.ToBlock); err != nil {
+					log.Printf("Reindex while paused failed for %s: %v", config.Name, err)
+				}
+			}
+			return i.waitForResume(ctrl, config)
+		}
+		// Resume (or any other command) un-pauses the monitor.
+		return false
+	}
+}
+
+// reindexTo backfills config from fromBlock+1 up to toBlock, capped at the
+// confirmed head, instead of letting monitorContract's usual confirmedHead
+// target pull a narrow reindex request into a full re-sync to the live tip.
+// Anything beyond toBlock is left for monitorContract's normal backfill step
+// to pick up on its next pass.
+func (i *Indexer) reindexTo(ctrl *contractControl, config ContractConfig, fromBlock, toBlock uint64) error {
+	head, err := i.confirmedHead(config)
+	if err != nil {
+		return err
+	}
+	if toBlock > head {
+		toBlock = head
+	}
+	if fromBlock >= toBlock {
+		return nil
+	}
+
+	ctx, cancel := stopContext(ctrl)
+	defer cancel()
+
+	backfiller := NewBackfiller(i, i.clients[config.ChainID])
+	ctrl.setBackfiller(backfiller)
+	defer ctrl.setBackfiller(nil)
+	return backfiller.Run(ctx, config, fromBlock+1, toBlock)
+}
+
+// stopContext returns a context canceled as soon as ctrl's stop channel
+// closes, so a Backfiller.Run invoked with it can be interrupted by an
+// admin stop instead of running an entire backfill to completion regardless.
+func stopContext(ctrl *contractControl) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-ctrl.stopChan:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// confirmedHead returns the latest block number minus config's confirmation
+// depth, i.e. the last block safe to treat as final.
+func (i *Indexer) confirmedHead(config ContractConfig) (uint64, error) {
+	currentBlock, err := i.clients[config.ChainID].BlockNumber(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get current block number: %w", err)
+	}
+
+	confirmations := config.Confirmations
+	if confirmations > 0 && currentBlock > confirmations {
+		return currentBlock - confirmations, nil
+	}
+	if confirmations > 0 {
+		return 0, nil
+	}
+	return currentBlock, nil
 }
 
 // processContractEvents processes new events for a contract
 func (i *Indexer) processContractEvents(config ContractConfig) error {
 	// Get the last processed block for this contract
-	lastBlock := i.getLastProcessedBlock(config.Address)
+	lastBlock := i.getLastProcessedBlock(config.ChainID, config.Address)
 	if lastBlock < config.StartBlock {
 		lastBlock = config.StartBlock
 	}
-	
-	// Get current block number
-	currentBlock, err := i.client.BlockNumber(context.Background())
+
+	// Detect and roll back any reorg before trusting lastBlock as a starting point
+	lastBlock, err := i.reconcileReorg(config, lastBlock)
 	if err != nil {
-		return fmt.Errorf("failed to get current block number: %w", err)
+		return fmt.Errorf("failed to reconcile reorg for %s: %w", config.Name, err)
 	}
-	
+
+	// Never index past the configured confirmation depth, so blocks that
+	// could still be reorged out are left for a later pass
+	currentBlock, err := i.confirmedHead(config)
+	if err != nil {
+		return err
+	}
+
 	// Skip if no new blocks
 	if lastBlock >= currentBlock {
 		return nil
@@ -138,37 +460,128 @@ func (i *Indexer) processContractEvents(config ContractConfig) error {
 		}
 		
 		// Update last processed block
-		i.updateLastProcessedBlock(config.Address, toBlock)
+		i.updateLastProcessedBlock(config.ChainID, config.Address, toBlock)
 	}
 	
 	return nil
 }
 
-// processBlockRange processes events in a specific block range
+// processBlockRange processes events in a specific block range. Contracts
+// with an ABI decoder configured get generic, multi-event decoding; all
+// others fall back to the hardcoded ERC-20 Transfer path.
 func (i *Indexer) processBlockRange(config ContractConfig, fromBlock, toBlock uint64) error {
-	// Create filter query for Transfer events
+	logs, err := i.fetchLogs(config, fromBlock, toBlock, i.topicsFor(config))
+	if err != nil {
+		return err
+	}
+
+	for _, vLog := range logs {
+		if err := i.processLog(config, vLog); err != nil {
+			log.Printf("Error processing event for %s: %v", config.Name, err)
+			continue
+		}
+	}
+
+	// Record a canonical hash for every block in the range's trailing
+	// blockHashWindow, not just the tip - batches are typically much larger
+	// than the window, and a single hash per batch leaves reconcileReorg's
+	// walk-back with almost nothing to compare against, forcing it to fall
+	// back to config.StartBlock on any multi-block reorg.
+	windowStart := fromBlock
+	if toBlock-fromBlock+1 > blockHashWindow {
+		windowStart = toBlock - blockHashWindow + 1
+	}
+	for b := windowStart; b <= toBlock; b++ {
+		header, err := i.clients[config.ChainID].HeaderByNumber(context.Background(), big.NewInt(int64(b)))
+		if err != nil {
+			return fmt.Errorf("failed to fetch header for block %d: %w", b, err)
+		}
+		if err := i.recordBlockHash(config, header); err != nil {
+			return err
+		}
+	}
+
+	i.controlFor(config).recordProcessed(len(logs))
+
+	log.Printf("Processed %d events for %s in blocks %d-%d", len(logs), config.Name, fromBlock, toBlock)
+	return nil
+}
+
+// fetchLogs runs a FilterLogs query for config's address over a block range
+// using the given topic filter.
+func (i *Indexer) fetchLogs(config ContractConfig, fromBlock, toBlock uint64, topics [][]common.Hash) ([]types.Log, error) {
 	query := ethereum.FilterQuery{
 		FromBlock: big.NewInt(int64(fromBlock)),
 		ToBlock:   big.NewInt(int64(toBlock)),
 		Addresses: []common.Address{config.Address},
-		Topics: [][]common.Hash{
-			{common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")}, // Transfer event signature
-		},
+		Topics:    topics,
 	}
-	
-	logs, err := i.client.FilterLogs(context.Background(), query)
+
+	logs, err := i.clients[config.ChainID].FilterLogs(context.Background(), query)
 	if err != nil {
-		return fmt.Errorf("failed to filter logs: %w", err)
+		return nil, fmt.Errorf("failed to filter logs: %w", err)
 	}
-	
-	for _, vLog := range logs {
-		if err := i.processTransferEvent(config, vLog); err != nil {
-			log.Printf("Error processing transfer event: %v", err)
+	return logs, nil
+}
+
+// topicsFor returns the log topic filter to use for config: its ABI
+// decoder's event selectors if one is configured, or the hardcoded ERC-20
+// Transfer signature otherwise. Used by both the batch block-range
+// processor and the live WebSocket subscription so both filter identically.
+func (i *Indexer) topicsFor(config ContractConfig) [][]common.Hash {
+	i.contractsMu.RLock()
+	d, ok := i.decoders[chainAddress{config.ChainID, config.Address}]
+	i.contractsMu.RUnlock()
+	if ok {
+		return d.Topics()
+	}
+	return [][]common.Hash{{common.HexToHash(transferEventSignature)}}
+}
+
+// processLog dispatches a single log to config's ABI decoder if one is
+// configured, or the hardcoded ERC-20 Transfer path otherwise. Used by both
+// the batch block-range processor and the live WebSocket subscription so
+// both paths decode events identically.
+func (i *Indexer) processLog(config ContractConfig, vLog types.Log) error {
+	i.contractsMu.RLock()
+	d, ok := i.decoders[chainAddress{config.ChainID, config.Address}]
+	i.contractsMu.RUnlock()
+	if ok {
+		return i.processDecodedEvent(config, d, vLog)
+	}
+	return i.processTransferEvent(config, vLog)
+}
+
+// processDecodedEvent decodes a log via the contract's ABI decoder, saves it
+// as a generic models.Event, and runs any plugin that handles its event
+// name to populate specialized tables such as Transaction.
+func (i *Indexer) processDecodedEvent(config ContractConfig, d *decoder.Decoder, vLog types.Log) error {
+	event, err := d.Decode(vLog)
+	if err != nil {
+		return fmt.Errorf("failed to decode event: %w", err)
+	}
+	event.ChainID = config.ChainID
+
+	db := database.GetDB()
+	if err := db.Create(event).Error; err != nil {
+		return fmt.Errorf("failed to save event: %w", err)
+	}
+
+	for _, plugin := range i.plugins {
+		if !plugin.Handles(event.EventName) {
 			continue
 		}
+
+		block, err := i.clients[config.ChainID].BlockByNumber(context.Background(), big.NewInt(int64(vLog.BlockNumber)))
+		if err != nil {
+			return fmt.Errorf("failed to get block: %w", err)
+		}
+
+		if err := plugin.Apply(config.Name, event, time.Unix(int64(block.Time()), 0)); err != nil {
+			return fmt.Errorf("plugin failed to apply event %s: %w", event.EventName, err)
+		}
 	}
-	
-	log.Printf("Processed %d events for %s in blocks %d-%d", len(logs), config.Name, fromBlock, toBlock)
+
 	return nil
 }
 
@@ -184,13 +597,14 @@ func (i *Indexer) processTransferEvent(config ContractConfig, vLog types.Log) er
 	amount := new(big.Int).SetBytes(vLog.Data[:32])
 	
 	// Get block timestamp
-	block, err := i.client.BlockByNumber(context.Background(), big.NewInt(int64(vLog.BlockNumber)))
+	block, err := i.clients[config.ChainID].BlockByNumber(context.Background(), big.NewInt(int64(vLog.BlockNumber)))
 	if err != nil {
 		return fmt.Errorf("failed to get block: %w", err)
 	}
-	
+
 	// Create transaction record
 	tx := &models.Transaction{
+		ChainID:         config.ChainID,
 		TxHash:          vLog.TxHash.Hex(),
 		BlockNumber:     vLog.BlockNumber,
 		LogIndex:        uint(vLog.Index),
@@ -207,57 +621,478 @@ func (i *Indexer) processTransferEvent(config ContractConfig, vLog types.Log) er
 	if err := db.Create(tx).Error; err != nil {
 		return fmt.Errorf("failed to save transaction: %w", err)
 	}
-	
+
 	return nil
 }
 
-// getLastProcessedBlock gets the last processed block for a contract
-func (i *Indexer) getLastProcessedBlock(address common.Address) uint64 {
+// getLastProcessedBlock gets the last processed block for a contract on a
+// given chain.
+func (i *Indexer) getLastProcessedBlock(chainID uint64, address common.Address) uint64 {
 	db := database.GetDB()
 	var progress models.BlockProgress
-	
-	if err := db.Where("contract = ?", address.Hex()).First(&progress).Error; err != nil {
+
+	if err := db.Where("chain_id = ? AND contract = ?", chainID, address.Hex()).First(&progress).Error; err != nil {
 		return 0
 	}
-	
+
 	return progress.LastBlock
 }
 
 // updateLastProcessedBlock updates the last processed block for a contract
-func (i *Indexer) updateLastProcessedBlock(address common.Address, blockNumber uint64) {
+// on a given chain.
+func (i *Indexer) updateLastProcessedBlock(chainID uint64, address common.Address, blockNumber uint64) {
 	db := database.GetDB()
-	
-	progress := models.BlockProgress{
-		Contract:  address.Hex(),
-		LastBlock: blockNumber,
+
+	db.Where("chain_id = ? AND contract = ?", chainID, address.Hex()).
+		Assign(models.BlockProgress{ChainID: chainID, Contract: address.Hex(), LastBlock: blockNumber}).
+		FirstOrCreate(&models.BlockProgress{})
+}
+
+// advanceLiveProgress records the canonical hash for blockNumber and moves
+// BlockProgress forward to it, mirroring what processBlockRange does for a
+// batch, but driven by a single block observed from a live subscription
+// instead of a whole processed range.
+func (i *Indexer) advanceLiveProgress(config ContractConfig, blockNumber uint64) error {
+	header, err := i.clients[config.ChainID].HeaderByNumber(context.Background(), big.NewInt(int64(blockNumber)))
+	if err != nil {
+		return fmt.Errorf("failed to fetch header for block %d: %w", blockNumber, err)
 	}
-	
-	db.Save(&progress)
+	if err := i.recordBlockHash(config, header); err != nil {
+		return err
+	}
+	i.updateLastProcessedBlock(config.ChainID, config.Address, blockNumber)
+	return nil
+}
+
+// reconcileReorg compares the stored canonical hash at lastBlock against the
+// chain's current view and, if they diverge, walks backwards through the
+// stored block-hash window until it finds the common ancestor, then rolls
+// back transactions and progress to that fork point. It returns the block to
+// resume processing from.
+func (i *Indexer) reconcileReorg(config ContractConfig, lastBlock uint64) (uint64, error) {
+	if lastBlock == 0 {
+		return lastBlock, nil
+	}
+
+	stored, err := i.getStoredBlockHash(config.ChainID, config.Address, lastBlock)
+	if err != nil {
+		// Nothing recorded yet for this block (e.g. first run after adding
+		// reorg support) - there is nothing to compare against
+		return lastBlock, nil
+	}
+
+	header, err := i.clients[config.ChainID].HeaderByNumber(context.Background(), big.NewInt(int64(lastBlock)))
+	if err != nil {
+		return lastBlock, fmt.Errorf("failed to fetch header for block %d: %w", lastBlock, err)
+	}
+
+	if header.Hash().Hex() == stored.BlockHash {
+		return lastBlock, nil
+	}
+
+	log.Printf("Reorg detected for %s at block %d: stored %s, chain %s", config.Name, lastBlock, stored.BlockHash, header.Hash().Hex())
+
+	forkPoint := config.StartBlock
+	for b := lastBlock - 1; b > config.StartBlock; b-- {
+		candidate, err := i.getStoredBlockHash(config.ChainID, config.Address, b)
+		if err != nil {
+			continue
+		}
+
+		chainHeader, err := i.clients[config.ChainID].HeaderByNumber(context.Background(), big.NewInt(int64(b)))
+		if err != nil {
+			return lastBlock, fmt.Errorf("failed to fetch header for block %d: %w", b, err)
+		}
+
+		if chainHeader.Hash().Hex() == candidate.BlockHash {
+			forkPoint = b
+			break
+		}
+	}
+
+	if err := i.rollbackToBlock(config.ChainID, config.Address, forkPoint); err != nil {
+		return lastBlock, fmt.Errorf("failed to roll back reorg for %s: %w", config.Name, err)
+	}
+
+	log.Printf("Rolled back %s to fork point %d", config.Name, forkPoint)
+	return forkPoint, nil
+}
+
+// getStoredBlockHash returns the block hash previously recorded for a
+// contract at a given block number on a given chain.
+func (i *Indexer) getStoredBlockHash(chainID uint64, address common.Address, blockNumber uint64) (models.BlockHash, error) {
+	db := database.GetDB()
+	var hash models.BlockHash
+	err := db.Where("chain_id = ? AND contract_address = ? AND block_number = ?", chainID, address.Hex(), blockNumber).First(&hash).Error
+	return hash, err
+}
+
+// rollbackToBlock deletes all indexed state for a contract beyond forkPoint
+// and resets its progress, undoing a chain reorg in a single transaction.
+func (i *Indexer) rollbackToBlock(chainID uint64, address common.Address, forkPoint uint64) error {
+	db := database.GetDB()
+
+	var dropped []models.Transaction
+	db.Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, address.Hex(), forkPoint).Find(&dropped)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, address.Hex(), forkPoint).
+			Delete(&models.Transaction{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, address.Hex(), forkPoint).
+			Delete(&models.BlockHash{}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, address.Hex(), forkPoint).
+			Delete(&models.Event{}).Error; err != nil {
+			return err
+		}
+
+		// Drop backfill checkpoints reaching past forkPoint, so the next
+		// backfill re-scans those blocks instead of skipping them as completed.
+		if err := tx.Where("chain_id = ? AND contract_address = ? AND to_block > ?", chainID, address.Hex(), forkPoint).
+			Delete(&models.BackfillRange{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("chain_id = ? AND contract = ?", chainID, address.Hex()).
+			Assign(models.BlockProgress{ChainID: chainID, Contract: address.Hex(), LastBlock: forkPoint}).
+			FirstOrCreate(&models.BlockProgress{}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	droppedHashes := make([]string, len(dropped))
+	for idx, tx := range dropped {
+		droppedHashes[idx] = tx.TxHash
+	}
+
+	// Record the rollback durably so the GraphQL reorg subscription, which
+	// runs in the separate server process, can pick it up by polling.
+	if err := db.Create(&models.ReorgEvent{
+		ChainID:         chainID,
+		ContractAddress: address.Hex(),
+		ForkBlock:       forkPoint,
+		DroppedTxHashes: droppedHashes,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to record reorg event: %w", err)
+	}
+
+	return nil
+}
+
+// recordBlockHash stores the canonical hash for a processed block and trims
+// the rolling window down to the most recent blockHashWindow entries.
+func (i *Indexer) recordBlockHash(config ContractConfig, header *types.Header) error {
+	db := database.GetDB()
+
+	record := models.BlockHash{
+		ChainID:         config.ChainID,
+		ContractAddress: config.Address.Hex(),
+		BlockNumber:     header.Number.Uint64(),
+		BlockHash:       header.Hash().Hex(),
+		ParentHash:      header.ParentHash.Hex(),
+	}
+
+	// Upsert rather than Create: a batch that errors partway through this
+	// header loop gets retried in full since it isn't marked Completed
+	// until it succeeds, which would otherwise insert a duplicate row for
+	// a block already recorded and leave getStoredBlockHash's unordered
+	// First() to pick whichever one GORM feels like.
+	err := db.Where("chain_id = ? AND contract_address = ? AND block_number = ?", record.ChainID, record.ContractAddress, record.BlockNumber).
+		Assign(record).
+		FirstOrCreate(&record).Error
+	if err != nil {
+		return fmt.Errorf("failed to record block hash: %w", err)
+	}
+
+	if record.BlockNumber < blockHashWindow {
+		return nil
+	}
+
+	return db.Where("chain_id = ? AND contract_address = ? AND block_number <= ?", config.ChainID, config.Address.Hex(), record.BlockNumber-blockHashWindow).
+		Delete(&models.BlockHash{}).Error
 }
 
-// loadContractsFromConfig loads contract configurations from config file
-func loadContractsFromConfig() []ContractConfig {
+// statusLogInterval is the heartbeat interval for logStatusPeriodically. It
+// isn't tied to any one chain's poll interval since contracts across chains
+// can have different cadences.
+const statusLogInterval = 15 * time.Second
+
+// logStatusPeriodically logs backfill/tailing progress for every contract
+// on a fixed heartbeat, complementing the indexerStatus GraphQL query.
+func (i *Indexer) logStatusPeriodically() {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(statusLogInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-i.stopChan:
+			return
+		case <-ticker.C:
+			for _, s := range i.Status() {
+				log.Printf("Status %s: state=%s lastBlock=%d lagBlocks=%d eventsPerSec=%.2f blocksPerSec=%.2f eta=%s rangesCompleted=%d", s.Contract, s.State, s.LastBlock, s.LagBlocks, s.EventsPerSec, s.BlocksPerSec, s.ETA, s.RangesCompleted)
+			}
+		}
+	}
+}
+
+// ContractStatus summarizes indexing progress for a single contract, used by
+// the periodic log heartbeat. The admin GET /indexer/status endpoint runs in
+// the server process and builds its own view from the database.
+type ContractStatus struct {
+	ChainID         uint64
+	Contract        string
+	Address         string
+	LastBlock       uint64
+	HeadBlock       uint64
+	LagBlocks       uint64
+	EventsPerSec    float64
+	BlocksPerSec    float64
+	ETA             time.Duration
+	State           string
+	RangesCompleted int64
+}
+
+// Status reports indexing progress for every configured contract, used by
+// logStatusPeriodically to log backfill/tailing progress.
+func (i *Indexer) Status() []ContractStatus {
+	db := database.GetDB()
+
+	i.contractsMu.RLock()
+	contracts := make([]ContractConfig, len(i.contracts))
+	copy(contracts, i.contracts)
+	i.contractsMu.RUnlock()
+
+	statuses := make([]ContractStatus, 0, len(contracts))
+	for _, c := range contracts {
+		var ranges int64
+		db.Model(&models.BackfillRange{}).
+			Where("chain_id = ? AND contract_address = ? AND completed = ?", c.ChainID, c.Address.Hex(), true).
+			Count(&ranges)
+
+		lastBlock := i.getLastProcessedBlock(c.ChainID, c.Address)
+
+		var headBlock uint64
+		if client, ok := i.clients[c.ChainID]; ok {
+			if head, err := client.BlockNumber(context.Background()); err == nil {
+				headBlock = head
+			}
+		}
+
+		var lagBlocks uint64
+		if headBlock > lastBlock {
+			lagBlocks = headBlock - lastBlock
+		}
+
+		ctrl := i.controlFor(c)
+
+		var blocksPerSec float64
+		var eta time.Duration
+		if backfiller := ctrl.getBackfiller(); backfiller != nil {
+			blocksPerSec, eta = backfiller.Progress(headBlock, lastBlock)
+		}
+
+		statuses = append(statuses, ContractStatus{
+			ChainID:         c.ChainID,
+			Contract:        c.Name,
+			Address:         c.Address.Hex(),
+			LastBlock:       lastBlock,
+			HeadBlock:       headBlock,
+			LagBlocks:       lagBlocks,
+			EventsPerSec:    ctrl.eventsPerSec(),
+			BlocksPerSec:    blocksPerSec,
+			ETA:             eta,
+			State:           ctrl.getState(),
+			RangesCompleted: ranges,
+		})
+	}
+
+	return statuses
+}
+
+// loadChainsFromConfig reads the "chains" list from config, dialing one
+// ethclient per chain and flattening every chain's contracts into a single
+// list, each tagged with its owning chain's ID, poll interval and
+// confirmation depth.
+func loadChainsFromConfig() (map[uint64]*ethclient.Client, map[uint64]chainMeta, []ContractConfig, error) {
+	chainsRaw := viper.Get("chains")
+	if chainsRaw == nil {
+		return nil, nil, nil, fmt.Errorf("no chains configured")
+	}
+
+	chainsList, ok := chainsRaw.([]interface{})
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("chains must be a list")
+	}
+
+	clients := make(map[uint64]*ethclient.Client)
+	chains := make(map[uint64]chainMeta)
 	var contracts []ContractConfig
-	
-	tokens := viper.Get("contracts.tokens")
-	if tokens == nil {
+
+	for _, rawChain := range chainsList {
+		chainMap := rawChain.(map[string]interface{})
+
+		chainID := uint64(chainMap["id"].(int))
+		providerURL, _ := chainMap["provider_url"].(string)
+		if providerURL == "" {
+			return nil, nil, nil, fmt.Errorf("chain %d has no provider_url configured", chainID)
+		}
+
+		client, err := ethclient.Dial(providerURL)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to connect to chain %d: %w", chainID, err)
+		}
+		clients[chainID] = client
+
+		pollInterval := 10
+		if v, ok := chainMap["poll_interval"].(int); ok {
+			pollInterval = v
+		}
+
+		var confirmations uint64
+		if v, ok := chainMap["confirmations"].(int); ok {
+			confirmations = uint64(v)
+		}
+
+		chains[chainID] = chainMeta{ProviderURL: providerURL, PollInterval: pollInterval, Confirmations: confirmations}
+		contracts = append(contracts, parseContractConfigs(chainMap["contracts"], chainID, providerURL, pollInterval, confirmations)...)
+	}
+
+	return clients, chains, contracts, nil
+}
+
+// parseContractConfigs parses the "contracts" list of a single chain entry,
+// stamping every contract with its owning chain's ID, provider URL, poll
+// interval and confirmation depth.
+func parseContractConfigs(raw interface{}, chainID uint64, providerURL string, pollInterval int, confirmations uint64) []ContractConfig {
+	var contracts []ContractConfig
+
+	if raw == nil {
 		return contracts
 	}
-	
-	tokensList := tokens.([]interface{})
+
+	tokensList := raw.([]interface{})
 	for _, token := range tokensList {
 		tokenMap := token.(map[string]interface{})
-		
+
 		name := tokenMap["name"].(string)
 		address := common.HexToAddress(tokenMap["address"].(string))
 		startBlock := uint64(tokenMap["start_block"].(int))
-		
+
+		abiFile, _ := tokenMap["abi_file"].(string)
+
+		var events []string
+		if rawEvents, ok := tokenMap["events"].([]interface{}); ok {
+			for _, e := range rawEvents {
+				if name, ok := e.(string); ok {
+					events = append(events, name)
+				}
+			}
+		}
+
 		contracts = append(contracts, ContractConfig{
-			Name:       name,
-			Address:    address,
-			StartBlock: startBlock,
+			Name:          name,
+			Address:       address,
+			StartBlock:    startBlock,
+			ABIFile:       abiFile,
+			Events:        events,
+			ChainID:       chainID,
+			ProviderURL:   providerURL,
+			PollInterval:  pollInterval,
+			Confirmations: confirmations,
 		})
 	}
-	
+
 	return contracts
+}
+
+// contractDiscoveryInterval is how often the indexer polls the database for
+// newly added Contract rows, so a POST /contracts on the admin API takes
+// effect on a running indexer without a restart.
+const contractDiscoveryInterval = 15 * time.Second
+
+// discoverContracts periodically polls the Contract table for active rows
+// not yet being monitored and starts them.
+func (i *Indexer) discoverContracts() {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(contractDiscoveryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-i.stopChan:
+			return
+		case <-ticker.C:
+			i.startNewContracts()
+		}
+	}
+}
+
+// startNewContracts loads every active Contract row and starts monitoring
+// any whose chain is configured and that aren't already running.
+func (i *Indexer) startNewContracts() {
+	db := database.GetDB()
+
+	var rows []models.Contract
+	if err := db.Where("is_active = ?", true).Find(&rows).Error; err != nil {
+		log.Printf("Failed to poll contracts table for newly added contracts: %v", err)
+		return
+	}
+
+	for _, c := range rows {
+		address := common.HexToAddress(c.Address)
+		key := chainAddress{c.ChainID, address}
+
+		i.controlMu.Lock()
+		_, known := i.controls[key]
+		i.controlMu.Unlock()
+		if known {
+			continue
+		}
+
+		chain, ok := i.chains[c.ChainID]
+		if !ok {
+			log.Printf("Skipping newly added contract %s: chain %d is not configured", c.Name, c.ChainID)
+			continue
+		}
+
+		config := ContractConfig{
+			Name:          c.Name,
+			Address:       address,
+			StartBlock:    c.StartBlock,
+			ABIFile:       c.ABIFile,
+			Events:        []string(c.Events),
+			ChainID:       c.ChainID,
+			ProviderURL:   chain.ProviderURL,
+			PollInterval:  chain.PollInterval,
+			Confirmations: chain.Confirmations,
+		}
+
+		if config.ABIFile != "" {
+			d, err := decoder.New(config.ABIFile, config.Events)
+			if err != nil {
+				log.Printf("Failed to load ABI for newly added contract %s: %v", c.Name, err)
+				continue
+			}
+			i.contractsMu.Lock()
+			i.decoders[key] = d
+			i.contractsMu.Unlock()
+		}
+
+		i.contractsMu.Lock()
+		i.contracts = append(i.contracts, config)
+		i.contractsMu.Unlock()
+
+		log.Printf("Discovered new contract %s (chain %d) from database, starting monitor", c.Name, c.ChainID)
+		i.wg.Add(1)
+		go i.monitorContract(config)
+	}
 }
\ No newline at end of file
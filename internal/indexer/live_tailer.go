@@ -0,0 +1,32 @@
+package indexer
+
+import (
+	"strings"
+)
+
+// LiveTailer follows the chain head for a single contract once its backfill
+// has caught up to within the confirmation window, using a WebSocket
+// subscription when available and falling back to polling otherwise.
+type LiveTailer struct {
+	indexer   *Indexer
+	transport Transport
+}
+
+// NewLiveTailer creates a LiveTailer bound to the given indexer, selecting a
+// SubscriptionTransport when config's chain provider_url is a ws:// or
+// wss:// endpoint, or a PollingTransport otherwise.
+func NewLiveTailer(i *Indexer, config ContractConfig) *LiveTailer {
+	var transport Transport
+	if strings.HasPrefix(config.ProviderURL, "ws://") || strings.HasPrefix(config.ProviderURL, "wss://") {
+		transport = NewSubscriptionTransport(i, i.clients[config.ChainID])
+	} else {
+		transport = NewPollingTransport(i)
+	}
+
+	return &LiveTailer{indexer: i, transport: transport}
+}
+
+// Run delivers new confirmed events for config until stopChan is closed.
+func (t *LiveTailer) Run(config ContractConfig, stopChan <-chan struct{}) {
+	t.transport.Run(config, stopChan)
+}
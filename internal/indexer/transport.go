@@ -0,0 +1,189 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Transport delivers new events for a contract to the live tailer, either by
+// polling or by subscribing to the node over a WebSocket connection.
+type Transport interface {
+	// Run delivers events for config until stopChan is closed.
+	Run(config ContractConfig, stopChan <-chan struct{})
+}
+
+// PollingTransport re-scans the confirmed block range on a fixed interval.
+type PollingTransport struct {
+	indexer *Indexer
+}
+
+// NewPollingTransport creates a PollingTransport bound to the given indexer.
+func NewPollingTransport(i *Indexer) *PollingTransport {
+	return &PollingTransport{indexer: i}
+}
+
+// Run polls for new confirmed blocks until stopChan is closed.
+func (t *PollingTransport) Run(config ContractConfig, stopChan <-chan struct{}) {
+	ticker := time.NewTicker(time.Duration(config.PollInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopChan:
+			return
+		case <-ticker.C:
+			if err := t.indexer.processContractEvents(config); err != nil {
+				log.Printf("Error polling %s: %v", config.Name, err)
+			}
+		}
+	}
+}
+
+// SubscriptionTransport follows a contract's events (its ABI decoder's
+// events, or the ERC-20 Transfer event otherwise) over an
+// ethclient.SubscribeFilterLogs WebSocket subscription, reconnecting with
+// exponential backoff and catching up any blocks missed while disconnected.
+type SubscriptionTransport struct {
+	indexer *Indexer
+	client  *ethclient.Client
+}
+
+// NewSubscriptionTransport creates a SubscriptionTransport bound to the
+// given indexer and client.
+func NewSubscriptionTransport(i *Indexer, client *ethclient.Client) *SubscriptionTransport {
+	return &SubscriptionTransport{indexer: i, client: client}
+}
+
+// Run subscribes to config's events until stopChan is closed,
+// reconnecting with exponential backoff on any subscription drop.
+func (t *SubscriptionTransport) Run(config ContractConfig, stopChan <-chan struct{}) {
+	const maxBackoff = 30 * time.Second
+	backoff := time.Second
+
+	for {
+		select {
+		case <-stopChan:
+			return
+		default:
+		}
+
+		if err := t.subscribeOnce(config, stopChan); err != nil {
+			log.Printf("Subscription for %s dropped: %v, reconnecting in %s", config.Name, err, backoff)
+
+			select {
+			case <-stopChan:
+				return
+			case <-time.After(backoff):
+			}
+
+			backoff *= 2
+			if backoff > maxBackoff {
+				backoff = maxBackoff
+			}
+			continue
+		}
+
+		backoff = time.Second
+	}
+}
+
+// subscribeOnce catches up on any events missed since the last processed
+// block, then streams new logs until the subscription drops or stopChan is
+// closed. It routes logs through the same decoder-aware dispatch as batch
+// processing, so a contract with an ABI decoder configured doesn't silently
+// fall back to Transfer-only decoding just because it's being tailed live.
+func (t *SubscriptionTransport) subscribeOnce(config ContractConfig, stopChan <-chan struct{}) error {
+	if err := t.indexer.processContractEvents(config); err != nil {
+		return fmt.Errorf("failed to catch up before subscribing: %w", err)
+	}
+
+	lastBlock := t.indexer.getLastProcessedBlock(config.ChainID, config.Address)
+
+	logsCh := make(chan types.Log, 256)
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{config.Address},
+		Topics:    t.indexer.topicsFor(config),
+	}
+
+	sub, err := t.client.SubscribeFilterLogs(context.Background(), query, logsCh)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to logs: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case <-stopChan:
+			return nil
+		case err := <-sub.Err():
+			return err
+		case vLog := <-logsCh:
+			if vLog.Removed {
+				// The node just told us a reorg invalidated this log -
+				// the tip is where chains fork, so this is the single
+				// most likely place to observe one. Reconcile now
+				// instead of inserting a ghost Transaction/Event and
+				// waiting for the next reconnect to notice.
+				log.Printf("Reorg signaled for %s: log at block %d removed", config.Name, vLog.BlockNumber)
+				if newLast, err := t.indexer.reconcileReorg(config, lastBlock); err != nil {
+					log.Printf("Error reconciling reorg for %s: %v", config.Name, err)
+				} else {
+					lastBlock = newLast
+				}
+				continue
+			}
+
+			// A reorg doesn't always redeliver a Removed log for this
+			// contract (it may not have emitted matching events on the
+			// orphaned side), so also check the stored hash at lastBlock
+			// against the chain on every new block the subscription
+			// sees, not only at reconnect.
+			if vLog.BlockNumber > lastBlock {
+				if newLast, err := t.indexer.reconcileReorg(config, lastBlock); err != nil {
+					log.Printf("Error reconciling reorg for %s: %v", config.Name, err)
+				} else if newLast < lastBlock {
+					// reconcileReorg already rolled state back to the fork
+					// point, but a SubscribeFilterLogs stream never
+					// redelivers a log once it's been read - vLog and
+					// anything else between the fork point and here would
+					// be silently dropped forever if we just moved on, so
+					// re-fetch and reprocess the whole range now.
+					if err := t.indexer.processBlockRange(config, newLast+1, vLog.BlockNumber); err != nil {
+						log.Printf("Error reprocessing %s after reorg: %v", config.Name, err)
+						lastBlock = newLast
+						continue
+					}
+					t.indexer.updateLastProcessedBlock(config.ChainID, config.Address, vLog.BlockNumber)
+					lastBlock = vLog.BlockNumber
+					continue
+				}
+			}
+
+			if err := t.indexer.processLog(config, vLog); err != nil {
+				log.Printf("Error processing subscribed event for %s: %v", config.Name, err)
+			}
+
+			// Advance progress and the reorg window as blocks stream in,
+			// not only around reconnects - a subscription can stay
+			// connected for hours, and without this BlockProgress/BlockHash
+			// go stale for as long as it holds, which both makes
+			// indexerStatus report a growing fake lag and forces
+			// processContractEvents to reprocess a huge already-delivered
+			// range on the next reconnect.
+			if vLog.BlockNumber > lastBlock {
+				if err := t.indexer.advanceLiveProgress(config, vLog.BlockNumber); err != nil {
+					log.Printf("Error advancing progress for %s: %v", config.Name, err)
+				} else {
+					lastBlock = vLog.BlockNumber
+				}
+			}
+		}
+	}
+}
@@ -1,15 +1,49 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 )
 
+// StringSlice is a []string persisted as a JSON array in a single text
+// column, used for the contract event allowlist.
+type StringSlice []string
+
+// Value implements driver.Valuer.
+func (s StringSlice) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(s)
+	return string(b), err
+}
+
+// Scan implements sql.Scanner.
+func (s *StringSlice) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return fmt.Errorf("unsupported Scan type for StringSlice: %T", value)
+	}
+}
+
 // Transaction represents a token transaction
 type Transaction struct {
 	ID          uint      `gorm:"primaryKey" json:"id"`
-	TxHash      string    `gorm:"uniqueIndex;not null" json:"tx_hash"`
+	ChainID     uint64    `gorm:"uniqueIndex:idx_tx_chain_hash_log,priority:1;index;not null" json:"chain_id"`
+	TxHash      string    `gorm:"uniqueIndex:idx_tx_chain_hash_log,priority:2;not null" json:"tx_hash"`
 	BlockNumber uint64    `gorm:"index;not null" json:"block_number"`
-	LogIndex    uint      `gorm:"not null" json:"log_index"`
+	LogIndex    uint      `gorm:"uniqueIndex:idx_tx_chain_hash_log,priority:3;not null" json:"log_index"`
 	
 	// Contract information
 	ContractAddress string `gorm:"index;not null" json:"contract_address"`
@@ -32,17 +66,19 @@ type Transaction struct {
 
 // Contract represents a monitored token contract
 type Contract struct {
-	ID          uint   `gorm:"primaryKey" json:"id"`
-	Name        string `gorm:"not null" json:"name"`
-	Address     string `gorm:"uniqueIndex;not null" json:"address"`
-	StartBlock  uint64 `gorm:"not null" json:"start_block"`
-	LastBlock   uint64 `gorm:"default:0" json:"last_block"`
-	ABIFile     string `json:"abi_file,omitempty"`
-	IsActive    bool   `gorm:"default:true" json:"is_active"`
-	
+	ID         uint        `gorm:"primaryKey" json:"id"`
+	Name       string      `gorm:"not null" json:"name"`
+	ChainID    uint64      `gorm:"uniqueIndex:idx_contract_chain_address,priority:1;index;not null" json:"chain_id"`
+	Address    string      `gorm:"uniqueIndex:idx_contract_chain_address,priority:2;not null" json:"address"`
+	StartBlock uint64      `gorm:"not null" json:"start_block"`
+	LastBlock  uint64      `gorm:"default:0" json:"last_block"`
+	ABIFile    string      `json:"abi_file,omitempty"`
+	Events     StringSlice `gorm:"type:text" json:"events,omitempty"`
+	IsActive   bool        `gorm:"default:true" json:"is_active"`
+
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
-	
+
 	// Relations
 	Transactions []Transaction `gorm:"foreignKey:ContractAddress;references:Address" json:"transactions,omitempty"`
 }
@@ -50,7 +86,91 @@ type Contract struct {
 // BlockProgress tracks indexing progress
 type BlockProgress struct {
 	ID          uint   `gorm:"primaryKey" json:"id"`
-	Contract    string `gorm:"uniqueIndex;not null" json:"contract"`
+	ChainID     uint64 `gorm:"uniqueIndex:idx_progress_chain_contract,priority:1;not null" json:"chain_id"`
+	Contract    string `gorm:"uniqueIndex:idx_progress_chain_contract,priority:2;not null" json:"contract"`
 	LastBlock   uint64 `gorm:"not null" json:"last_block"`
 	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// BlockHash tracks a rolling window of recently seen canonical block hashes
+// per contract, so the indexer can detect a chain reorg by comparing what it
+// stored against the chain's current view and find the common ancestor.
+type BlockHash struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	ChainID         uint64    `gorm:"uniqueIndex:idx_block_hash_contract_block,priority:1;not null" json:"chain_id"`
+	ContractAddress string    `gorm:"uniqueIndex:idx_block_hash_contract_block,priority:2;not null" json:"contract_address"`
+	BlockNumber     uint64    `gorm:"uniqueIndex:idx_block_hash_contract_block,priority:3;not null" json:"block_number"`
+	BlockHash       string    `gorm:"not null" json:"block_hash"`
+	ParentHash      string    `gorm:"not null" json:"parent_hash"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// BackfillRange tracks a historical block range claimed by a backfill
+// worker, so a restarted backfill can resume without re-scanning ranges that
+// already completed.
+type BackfillRange struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	ChainID         uint64    `gorm:"index:idx_backfill_range_contract_from,priority:1;not null" json:"chain_id"`
+	ContractAddress string    `gorm:"index:idx_backfill_range_contract_from,priority:2;not null" json:"contract_address"`
+	FromBlock       uint64    `gorm:"index:idx_backfill_range_contract_from,priority:3;not null" json:"from_block"`
+	ToBlock         uint64    `gorm:"not null" json:"to_block"`
+	Completed       bool      `gorm:"default:false" json:"completed"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ContractAction identifies the kind of runtime command a ContractCommand
+// carries.
+type ContractAction string
+
+const (
+	ContractActionStop    ContractAction = "stop"
+	ContractActionPause   ContractAction = "pause"
+	ContractActionResume  ContractAction = "resume"
+	ContractActionReindex ContractAction = "reindex"
+)
+
+// ContractCommand is a queued admin instruction for a single monitored
+// contract. The admin API and the indexer run as separate processes with no
+// shared memory, so commands are handed off through this table instead of
+// an in-process channel: the server inserts a row, and the indexer polls
+// for unprocessed ones and marks them processed once handled.
+type ContractCommand struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	ChainID     uint64     `gorm:"index;not null" json:"chain_id"`
+	Address     string     `gorm:"index;not null" json:"address"`
+	Action      string     `gorm:"not null" json:"action"`
+	FromBlock   uint64     `json:"from_block"`
+	ToBlock     uint64     `json:"to_block"`
+	Processed   bool       `gorm:"index;default:false" json:"processed"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ProcessedAt *time.Time `json:"processed_at,omitempty"`
+}
+
+// ReorgEvent records a chain reorg rollback so the GraphQL "reorg"
+// subscription can pick it up by polling: the server and indexer run as
+// separate processes with no shared memory, so the rollback is persisted
+// here instead of published over an in-process channel.
+type ReorgEvent struct {
+	ID              uint        `gorm:"primaryKey" json:"id"`
+	ChainID         uint64      `gorm:"index;not null" json:"chain_id"`
+	ContractAddress string      `gorm:"index;not null" json:"contract_address"`
+	ForkBlock       uint64      `gorm:"not null" json:"fork_block"`
+	DroppedTxHashes StringSlice `gorm:"type:text" json:"dropped_tx_hashes,omitempty"`
+	CreatedAt       time.Time   `json:"created_at"`
+}
+
+// Event is a generic, ABI-decoded contract event. It covers event types
+// beyond the specialized Transaction table - ERC-721 Transfers, ERC-1155
+// TransferSingle/Batch, Approvals, and custom protocol events.
+type Event struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	ChainID         uint64    `gorm:"uniqueIndex:idx_event_chain_hash_log,priority:1;index:idx_event_contract_name,priority:1;not null" json:"chain_id"`
+	ContractAddress string    `gorm:"index:idx_event_contract_name,priority:2;not null" json:"contract_address"`
+	EventName       string    `gorm:"index:idx_event_contract_name,priority:3;not null" json:"event_name"`
+	BlockNumber     uint64    `gorm:"index;not null" json:"block_number"`
+	LogIndex        uint      `gorm:"uniqueIndex:idx_event_chain_hash_log,priority:3;not null" json:"log_index"`
+	TxHash          string    `gorm:"uniqueIndex:idx_event_chain_hash_log,priority:2;not null" json:"tx_hash"`
+	Args            string    `gorm:"type:jsonb" json:"args"` // JSON-encoded decoded event arguments
+	CreatedAt       time.Time `json:"created_at"`
 }
\ No newline at end of file
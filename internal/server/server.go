@@ -1,22 +1,34 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	coderws "github.com/coder/websocket"
+	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/viper"
-	
+	"gorm.io/gorm"
+
 	"github.com/user/coin-indexer/internal/database"
 	"github.com/user/coin-indexer/internal/graphql"
 	"github.com/user/coin-indexer/internal/models"
 )
 
+// eventRateWindow is the trailing window GET /indexer/status averages
+// eventsPerSec over, since the server runs as a separate process from the
+// indexer and has no access to its live per-contract counters.
+const eventRateWindow = 10 * time.Second
+
 type Server struct {
-	router *gin.Engine
+	router  *gin.Engine
+	clients map[uint64]*ethclient.Client
 }
 
 // NewServer creates a new GraphQL server
@@ -46,24 +58,83 @@ func NewServer() (*Server, error) {
 		c.Next()
 	})
 	
-	// GraphQL endpoint with enhanced configuration
-	srv := handler.NewDefaultServer(graphql.NewExecutableSchema(graphql.Config{
+	// GraphQL endpoint, wired up by hand (rather than handler.NewDefaultServer)
+	// so we can add the Websocket transport for subscriptions
+	srv := handler.New(graphql.NewExecutableSchema(graphql.Config{
 		Resolvers: &graphql.Resolver{},
 	}))
-	
-	// Handle GraphQL requests
+
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Implementation: transport.CoderWebsocketImplementation{
+			AcceptOptions: coderws.AcceptOptions{InsecureSkipVerify: true},
+		},
+	})
+
+	// Handle GraphQL requests (GET/POST/WS all route through the same handler)
+	router.GET("/graphql", gin.WrapH(srv))
 	router.POST("/graphql", gin.WrapH(srv))
 	
 	// GraphQL playground (enhanced GraphiQL-style interface)
 	router.GET("/playground", gin.WrapH(playground.Handler("Coin Indexer - GraphQL Playground", "/graphql")))
 	router.GET("/graphiql", gin.WrapH(playground.Handler("Coin Indexer - GraphiQL", "/graphql")))
 	
+	clients, err := dialChainClients()
+	if err != nil {
+		log.Printf("Warning: failed to dial chain clients for admin status: %v", err)
+	}
+	s := &Server{router: router, clients: clients}
+
 	// REST endpoints
 	router.GET("/", rootHandler)
 	router.POST("/contracts", addContractHandler)
+	router.DELETE("/contracts/:address", deleteContractHandler)
+	router.POST("/contracts/:address/pause", pauseContractHandler)
+	router.POST("/contracts/:address/resume", resumeContractHandler)
+	router.POST("/contracts/:address/reindex", reindexContractHandler)
+	router.GET("/indexer/status", s.indexerStatusHandler)
 	router.GET("/health", healthHandler)
-	
-	return &Server{router: router}, nil
+
+	return s, nil
+}
+
+// dialChainClients dials one ethclient per configured chain, used by
+// GET /indexer/status to compute headBlock/lagBlocks. A chain that fails to
+// dial is skipped rather than failing server startup.
+func dialChainClients() (map[uint64]*ethclient.Client, error) {
+	clients := make(map[uint64]*ethclient.Client)
+
+	chainsRaw := viper.Get("chains")
+	if chainsRaw == nil {
+		return clients, nil
+	}
+
+	chainsList, ok := chainsRaw.([]interface{})
+	if !ok {
+		return clients, fmt.Errorf("chains must be a list")
+	}
+
+	for _, rawChain := range chainsList {
+		chainMap := rawChain.(map[string]interface{})
+		chainID := uint64(chainMap["id"].(int))
+
+		providerURL, _ := chainMap["provider_url"].(string)
+		if providerURL == "" {
+			continue
+		}
+
+		client, err := ethclient.Dial(providerURL)
+		if err != nil {
+			log.Printf("Warning: failed to connect to chain %d: %v", chainID, err)
+			continue
+		}
+		clients[chainID] = client
+	}
+
+	return clients, nil
 }
 
 // Start starts the HTTP server
@@ -87,20 +158,22 @@ func (s *Server) Start() error {
 func addContractHandler(c *gin.Context) {
 	var req struct {
 		Name       string `json:"name" binding:"required"`
+		ChainID    uint64 `json:"chain_id" binding:"required"`
 		Address    string `json:"address" binding:"required"`
 		StartBlock uint64 `json:"start_block"`
 	}
-	
+
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
-	
+
 	log.Printf("DEBUG: Received request: %+v", req)
-	
+
 	// Create new contract record
 	contract := models.Contract{
 		Name:       req.Name,
+		ChainID:    req.ChainID,
 		Address:    req.Address,
 		StartBlock: req.StartBlock,
 		LastBlock:  0,
@@ -127,6 +200,209 @@ func addContractHandler(c *gin.Context) {
 	})
 }
 
+// deleteContractHandler marks a contract inactive and signals its indexer
+// goroutine to stop, without requiring a process restart.
+func deleteContractHandler(c *gin.Context) {
+	address := c.Param("address")
+
+	db := database.GetDB()
+	query := db.Where("address = ?", address)
+	if chainID := c.Query("chain_id"); chainID != "" {
+		query = query.Where("chain_id = ?", chainID)
+	}
+
+	var contracts []models.Contract
+	if err := query.Find(&contracts).Error; err != nil || len(contracts) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "contract not found"})
+		return
+	}
+
+	ids := make([]uint, len(contracts))
+	for idx, contract := range contracts {
+		ids[idx] = contract.ID
+	}
+
+	if err := db.Model(&models.Contract{}).Where("id IN ?", ids).
+		Update("is_active", false).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to deactivate contract"})
+		return
+	}
+
+	for _, contract := range contracts {
+		queueContractCommand(db, contract, models.ContractActionStop, 0, 0)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "contract deactivated", "contracts": len(contracts)})
+}
+
+// pauseContractHandler signals a contract's indexer goroutine to stop
+// tailing new blocks until resumed.
+func pauseContractHandler(c *gin.Context) {
+	publishContractCommand(c, models.ContractActionPause, 0, 0)
+}
+
+// resumeContractHandler signals a paused contract's indexer goroutine to
+// resume tailing.
+func resumeContractHandler(c *gin.Context) {
+	publishContractCommand(c, models.ContractActionResume, 0, 0)
+}
+
+// reindexContractHandler forces a contract to roll back to fromBlock and
+// re-backfill up to toBlock.
+func reindexContractHandler(c *gin.Context) {
+	var req struct {
+		FromBlock uint64 `json:"fromBlock"`
+		ToBlock   uint64 `json:"toBlock"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.ToBlock != 0 && req.ToBlock < req.FromBlock {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "toBlock must be >= fromBlock"})
+		return
+	}
+
+	if req.FromBlock > 0 {
+		address := c.Param("address")
+		db := database.GetDB()
+		query := db.Where("address = ?", address)
+		if chainID := c.Query("chain_id"); chainID != "" {
+			query = query.Where("chain_id = ?", chainID)
+		}
+
+		var contracts []models.Contract
+		if err := query.Find(&contracts).Error; err != nil || len(contracts) == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": "contract not found"})
+			return
+		}
+
+		for _, contract := range contracts {
+			var progress models.BlockProgress
+			db.Where("chain_id = ? AND contract = ?", contract.ChainID, contract.Address).First(&progress)
+			if req.FromBlock > progress.LastBlock+1 {
+				c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf(
+					"fromBlock %d is ahead of %s's last processed block %d on chain %d",
+					req.FromBlock, contract.Address, progress.LastBlock, contract.ChainID)})
+				return
+			}
+		}
+	}
+
+	publishContractCommand(c, models.ContractActionReindex, req.FromBlock, req.ToBlock)
+}
+
+// publishContractCommand looks up every contract matching the :address path
+// param (optionally narrowed by a chain_id query param, since the same
+// address can be monitored on more than one chain) and queues action for
+// each as a ContractCommand row. The admin API and the indexer run as
+// separate processes with no shared memory, so the indexer picks these up
+// by polling the table rather than through an in-process channel.
+func publishContractCommand(c *gin.Context, action models.ContractAction, fromBlock, toBlock uint64) {
+	address := c.Param("address")
+
+	db := database.GetDB()
+	query := db.Where("address = ?", address)
+	if chainID := c.Query("chain_id"); chainID != "" {
+		query = query.Where("chain_id = ?", chainID)
+	}
+
+	var contracts []models.Contract
+	if err := query.Find(&contracts).Error; err != nil || len(contracts) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "contract not found"})
+		return
+	}
+
+	for _, contract := range contracts {
+		if err := queueContractCommand(db, contract, action, fromBlock, toBlock); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to queue command"})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": fmt.Sprintf("%s command queued", action), "contracts": len(contracts)})
+}
+
+// queueContractCommand inserts a ContractCommand row for the indexer process
+// to pick up on its next poll.
+func queueContractCommand(db *gorm.DB, contract models.Contract, action models.ContractAction, fromBlock, toBlock uint64) error {
+	return db.Create(&models.ContractCommand{
+		ChainID:   contract.ChainID,
+		Address:   contract.Address,
+		Action:    string(action),
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+	}).Error
+}
+
+// contractStatusResponse is the GET /indexer/status shape for a single
+// contract.
+type contractStatusResponse struct {
+	ChainID      uint64  `json:"chainId"`
+	Contract     string  `json:"contract"`
+	Address      string  `json:"address"`
+	LastBlock    uint64  `json:"lastBlock"`
+	HeadBlock    uint64  `json:"headBlock"`
+	LagBlocks    uint64  `json:"lagBlocks"`
+	EventsPerSec float64 `json:"eventsPerSec"`
+	State        string  `json:"state"`
+}
+
+// indexerStatusHandler reports per-contract indexing progress, sourced
+// entirely from the database and a live head-block lookup since this
+// process doesn't share memory with the indexer.
+func (s *Server) indexerStatusHandler(c *gin.Context) {
+	db := database.GetDB()
+
+	var contracts []models.Contract
+	if err := db.Find(&contracts).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load contracts"})
+		return
+	}
+
+	statuses := make([]contractStatusResponse, 0, len(contracts))
+	for _, contract := range contracts {
+		var progress models.BlockProgress
+		db.Where("chain_id = ? AND contract = ?", contract.ChainID, contract.Address).First(&progress)
+
+		var headBlock uint64
+		if client, ok := s.clients[contract.ChainID]; ok {
+			if head, err := client.BlockNumber(context.Background()); err == nil {
+				headBlock = head
+			}
+		}
+
+		var lagBlocks uint64
+		if headBlock > progress.LastBlock {
+			lagBlocks = headBlock - progress.LastBlock
+		}
+
+		var recent int64
+		db.Model(&models.Transaction{}).
+			Where("chain_id = ? AND contract_address = ? AND created_at >= ?", contract.ChainID, contract.Address, time.Now().Add(-eventRateWindow)).
+			Count(&recent)
+
+		state := "tailing"
+		if !contract.IsActive {
+			state = "stopped"
+		}
+
+		statuses = append(statuses, contractStatusResponse{
+			ChainID:      contract.ChainID,
+			Contract:     contract.Name,
+			Address:      contract.Address,
+			LastBlock:    progress.LastBlock,
+			HeadBlock:    headBlock,
+			LagBlocks:    lagBlocks,
+			EventsPerSec: float64(recent) / eventRateWindow.Seconds(),
+			State:        state,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{"contracts": statuses})
+}
+
 // rootHandler provides API information and links
 func rootHandler(c *gin.Context) {
 	host := c.Request.Host